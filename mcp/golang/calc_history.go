@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxHistorySize はメモリ上に保持する計算履歴の最大件数です。
+const maxHistorySize = 50
+
+// calcHistoryEntry は計算ツールの1回の呼び出し記録です。
+type calcHistoryEntry struct {
+	Operation string    `json:"operation"`
+	X         float64   `json:"x"`
+	Y         float64   `json:"y"`
+	Result    float64   `json:"result,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// calcHistory はcalculateツールの直近の呼び出しをメモリ上に保持し、
+// calc://historyリソースとして公開します。
+type calcHistory struct {
+	mu      sync.Mutex
+	entries []calcHistoryEntry
+	server  *server.MCPServer
+}
+
+// newCalcHistory はsに紐づくcalcHistoryを作成します。
+func newCalcHistory(s *server.MCPServer) *calcHistory {
+	return &calcHistory{server: s}
+}
+
+// record は計算呼び出しを履歴に追加し、リソースの購読者に更新を通知します。
+func (h *calcHistory) record(entry calcHistoryEntry) {
+	h.mu.Lock()
+	h.entries = append(h.entries, entry)
+	if len(h.entries) > maxHistorySize {
+		h.entries = h.entries[len(h.entries)-maxHistorySize:]
+	}
+	h.mu.Unlock()
+
+	h.server.SendNotificationToAllClients(mcp.MethodNotificationResourceUpdated, map[string]any{"uri": "calc://history"})
+}
+
+// handleResource はcalc://historyリソースの読み取りを処理します。
+func (h *calcHistory) handleResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	h.mu.Lock()
+	entries := make([]calcHistoryEntry, len(h.entries))
+	copy(entries, h.entries)
+	h.mu.Unlock()
+
+	body, err := json.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tokenKind はevaluateツールの式パーサーが扱うトークンの種類です。
+type tokenKind int
+
+const (
+	tokNumber     tokenKind = iota
+	tokIdent                // 関数名または定数（pi, e, sin, sqrt, ...）
+	tokOp                   // +, -, *, /, %, ^
+	tokUnaryMinus           // 単項マイナス
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+}
+
+// functions はevaluateツールが認識する1引数関数の一覧です。
+var functions = map[string]func(float64) (float64, error){
+	"sin":  func(x float64) (float64, error) { return math.Sin(x), nil },
+	"cos":  func(x float64) (float64, error) { return math.Cos(x), nil },
+	"tan":  func(x float64) (float64, error) { return math.Tan(x), nil },
+	"sqrt": sqrtFn,
+	"log":  logFn,
+}
+
+// constants はevaluateツールが認識する定数の一覧です。
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+func sqrtFn(x float64) (float64, error) {
+	if x < 0 {
+		return 0, fmt.Errorf("sqrtの引数が負数です: %g", x)
+	}
+	return math.Sqrt(x), nil
+}
+
+func logFn(x float64) (float64, error) {
+	if x <= 0 {
+		return 0, fmt.Errorf("logの引数は正数である必要があります: %g", x)
+	}
+	return math.Log(x), nil
+}
+
+// opPrecedence は各演算子の優先順位です。数値が大きいほど優先されます。
+var opPrecedence = map[string]int{
+	"+": 1,
+	"-": 1,
+	"*": 2,
+	"/": 2,
+	"%": 2,
+	"^": 3,
+}
+
+// opRightAssoc は右結合の演算子かどうかを示します（冪乗のみ）。
+var opRightAssoc = map[string]bool{
+	"^": true,
+}
+
+// unaryMinusPrecedence は単項マイナスの優先順位です。*, /, %と同じ帯にすることで、
+// "-2^2"が"(-2)^2"ではなく"-(2^2)"（多くの言語・電卓と同じ慣習）と評価されるようにします。
+const unaryMinusPrecedence = 2
+
+// precedenceOf はtoPostfixでスタック上のトークンと比較するための優先順位を返します。
+// 関数名（tokIdent）など優先順位を持たないトークンの場合はokにfalseを返します。
+func precedenceOf(t token) (prec int, ok bool) {
+	switch t.kind {
+	case tokOp:
+		return opPrecedence[t.text], true
+	case tokUnaryMinus:
+		return unaryMinusPrecedence, true
+	default:
+		return 0, false
+	}
+}
+
+// evaluateExpression はinfix記法の数式exprを評価し、結果を返します。
+// 内部でシャンティングヤードアルゴリズムにより逆ポーランド記法（postfix）へ変換してから評価します。
+func evaluateExpression(expr string) (float64, error) {
+	tokens, err := tokenizeExpression(expr)
+	if err != nil {
+		return 0, err
+	}
+	postfix, err := toPostfix(tokens)
+	if err != nil {
+		return 0, err
+	}
+	return evalPostfix(postfix)
+}
+
+// tokenizeExpression はexprをトークン列に分解します。
+func tokenizeExpression(expr string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expr)
+
+	prevSignificant := func() *token {
+		if len(tokens) == 0 {
+			return nil
+		}
+		return &tokens[len(tokens)-1]
+	}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			num, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("不正な数値です: %s", text)
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: text, num: num})
+
+		case unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && unicode.IsLetter(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: string(runes[start:i])})
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "("})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")"})
+			i++
+
+		case strings.ContainsRune("+-*/%^", r):
+			// 式の先頭、演算子の直後、または開き括弧の直後にある'-'は単項マイナスとして扱う
+			if r == '-' {
+				prev := prevSignificant()
+				if prev == nil || prev.kind == tokOp || prev.kind == tokLParen || prev.kind == tokUnaryMinus {
+					tokens = append(tokens, token{kind: tokUnaryMinus, text: "u-"})
+					i++
+					continue
+				}
+			}
+			tokens = append(tokens, token{kind: tokOp, text: string(r)})
+			i++
+
+		default:
+			return nil, fmt.Errorf("不正な文字です: %q", string(r))
+		}
+	}
+
+	return tokens, nil
+}
+
+// toPostfix はシャンティングヤードアルゴリズムでトークン列を逆ポーランド記法に変換します。
+func toPostfix(tokens []token) ([]token, error) {
+	var output []token
+	var opStack []token
+
+	popToOutput := func() {
+		output = append(output, opStack[len(opStack)-1])
+		opStack = opStack[:len(opStack)-1]
+	}
+
+	for _, t := range tokens {
+		switch t.kind {
+		case tokNumber:
+			output = append(output, t)
+
+		case tokIdent:
+			name := strings.ToLower(t.text)
+			if _, ok := constants[name]; ok {
+				output = append(output, token{kind: tokNumber, num: constants[name], text: name})
+				continue
+			}
+			if _, ok := functions[name]; ok {
+				opStack = append(opStack, token{kind: tokIdent, text: name})
+				continue
+			}
+			return nil, fmt.Errorf("未知の識別子です: %s", t.text)
+
+		case tokUnaryMinus:
+			opStack = append(opStack, t)
+
+		case tokOp:
+			for len(opStack) > 0 {
+				top := opStack[len(opStack)-1]
+				if top.kind == tokLParen {
+					break
+				}
+				topPrec, ok := precedenceOf(top)
+				if !ok {
+					break
+				}
+				if topPrec > opPrecedence[t.text] ||
+					(topPrec == opPrecedence[t.text] && !opRightAssoc[t.text]) {
+					popToOutput()
+					continue
+				}
+				break
+			}
+			opStack = append(opStack, t)
+
+		case tokLParen:
+			opStack = append(opStack, t)
+
+		case tokRParen:
+			matched := false
+			for len(opStack) > 0 {
+				top := opStack[len(opStack)-1]
+				if top.kind == tokLParen {
+					opStack = opStack[:len(opStack)-1]
+					matched = true
+					break
+				}
+				popToOutput()
+			}
+			if !matched {
+				return nil, fmt.Errorf("括弧の対応が取れていません: 対応する'('がありません")
+			}
+			// 関数呼び出しの括弧が閉じた場合、関数自体もポップする
+			if len(opStack) > 0 && opStack[len(opStack)-1].kind == tokIdent {
+				popToOutput()
+			}
+		}
+	}
+
+	for len(opStack) > 0 {
+		top := opStack[len(opStack)-1]
+		if top.kind == tokLParen {
+			return nil, fmt.Errorf("括弧の対応が取れていません: 対応する')'がありません")
+		}
+		popToOutput()
+	}
+
+	return output, nil
+}
+
+// evalPostfix は逆ポーランド記法のトークン列をfloat64スタックで評価します。
+func evalPostfix(postfix []token) (float64, error) {
+	var stack []float64
+
+	pop := func() (float64, error) {
+		if len(stack) == 0 {
+			return 0, fmt.Errorf("式の構文が不正です")
+		}
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v, nil
+	}
+
+	for _, t := range postfix {
+		switch t.kind {
+		case tokNumber:
+			stack = append(stack, t.num)
+
+		case tokUnaryMinus:
+			v, err := pop()
+			if err != nil {
+				return 0, err
+			}
+			stack = append(stack, -v)
+
+		case tokIdent:
+			fn, ok := functions[t.text]
+			if !ok {
+				return 0, fmt.Errorf("未知の識別子です: %s", t.text)
+			}
+			v, err := pop()
+			if err != nil {
+				return 0, err
+			}
+			result, err := fn(v)
+			if err != nil {
+				return 0, err
+			}
+			stack = append(stack, result)
+
+		case tokOp:
+			b, err := pop()
+			if err != nil {
+				return 0, err
+			}
+			a, err := pop()
+			if err != nil {
+				return 0, err
+			}
+			switch t.text {
+			case "+":
+				stack = append(stack, a+b)
+			case "-":
+				stack = append(stack, a-b)
+			case "*":
+				stack = append(stack, a*b)
+			case "/":
+				if b == 0 {
+					return 0, fmt.Errorf("0による除算はできません")
+				}
+				stack = append(stack, a/b)
+			case "%":
+				if b == 0 {
+					return 0, fmt.Errorf("0による剰余演算はできません")
+				}
+				stack = append(stack, math.Mod(a, b))
+			case "^":
+				stack = append(stack, math.Pow(a, b))
+			}
+		}
+	}
+
+	if len(stack) != 1 {
+		return 0, fmt.Errorf("式の構文が不正です")
+	}
+	return stack[0], nil
+}
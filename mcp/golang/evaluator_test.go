@@ -0,0 +1,62 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want float64
+	}{
+		{"addition", "1 + 2", 3},
+		{"precedence", "2 + 3 * 4", 14},
+		{"parens", "(2 + 3) * 4", 20},
+		{"unary minus", "-2 + 3", 1},
+		{"unary minus before multiplication", "-2 * 3", -6},
+		{"unary minus before exponent", "-2^2", -4},
+		{"exponent before unary minus operand", "2^-2", 0.25},
+		{"double unary minus", "--2", 2},
+		{"right associative exponent", "2^3^2", 512},
+		{"function call", "sqrt(16)", 4},
+		{"constant", "pi", math.Pi},
+		{"nested function and exponent", "sqrt(2)^2", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evaluateExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("evaluateExpression(%q) returned error: %v", tt.expr, err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("evaluateExpression(%q) = %g, want %g", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateExpressionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"unmatched opening paren", "(1 + 2"},
+		{"unmatched closing paren", "1 + 2)"},
+		{"unknown identifier", "foo(1)"},
+		{"division by zero", "1 / 0"},
+		{"sqrt of negative", "sqrt(-1)"},
+		{"log of zero", "log(0)"},
+		{"invalid character", "1 + $"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := evaluateExpression(tt.expr); err == nil {
+				t.Errorf("evaluateExpression(%q) expected an error, got nil", tt.expr)
+			}
+		})
+	}
+}
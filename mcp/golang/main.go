@@ -2,14 +2,23 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
 func main() {
+	// トランスポート設定の読み込み（未指定時は環境変数、さらに未設定ならデフォルト値）
+	transport := flag.String("transport", envOrDefault("MCP_TRANSPORT", "stdio"), "利用するトランスポート（stdio, sse, streamable-http）")
+	addr := flag.String("addr", envOrDefault("MCP_ADDR", ":8080"), "SSE/streamable-httpサーバーのバインドアドレス")
+	basePath := flag.String("base-path", envOrDefault("MCP_BASE_PATH", ""), "SSE/streamable-httpエンドポイントのパスプレフィックス")
+	authToken := flag.String("auth-token", envOrDefault("MCP_AUTH_TOKEN", ""), "SSE/streamable-httpで要求するBearerトークン（空の場合は認証なし）")
+	flag.Parse()
+
 	log.Println("MCPサーバーを起動します...")
 	// MCPサーバーインスタンスの作成
 	s := server.NewMCPServer(
@@ -20,6 +29,17 @@ func main() {
 	)
 	log.Println("MCPサーバーが作成されました")
 
+	// 計算履歴リソースの登録
+	history := newCalcHistory(s)
+	s.AddResource(
+		mcp.NewResource("calc://history", "計算履歴",
+			mcp.WithResourceDescription("直近の計算ツール呼び出し履歴"),
+			mcp.WithMIMEType("application/json"),
+		),
+		history.handleResource,
+	)
+	log.Println("計算履歴リソースが追加されました")
+
 	// 四則演算ツールのインターフェース定義
 	calculatorTool := mcp.NewTool("calculate",
 		mcp.WithDescription("基本的な四則演算を実行します"),
@@ -36,14 +56,26 @@ func main() {
 			mcp.Required(),
 			mcp.Description("2番目の数値"),
 		),
+		mcp.WithNumber("precision",
+			mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）"),
+		),
 	)
 
 	// 四則演算ツールの実装
 	s.AddTool(calculatorTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		// パラメータの取得
-		op := request.Params.Arguments["operation"].(string)
-		x := request.Params.Arguments["x"].(float64)
-		y := request.Params.Arguments["y"].(float64)
+		op, err := request.RequireString("operation")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		x, err := request.RequireFloat("x")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		y, err := request.RequireFloat("y")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		var result float64
 		switch op {
@@ -55,21 +87,33 @@ func main() {
 			result = x * y
 		case "divide": // 除算
 			if y == 0 {
+				history.record(calcHistoryEntry{Operation: op, X: x, Y: y, Error: "0による除算はできません", Timestamp: time.Now()})
 				return mcp.NewToolResultError("0による除算はできません"), nil
 			}
 			result = x / y
 		}
 
-		// 結果を小数点以下2桁まで表示
-		return mcp.NewToolResultText(fmt.Sprintf("%.2f", result)), nil
+		history.record(calcHistoryEntry{Operation: op, X: x, Y: y, Result: result, Timestamp: time.Now()})
+
+		precision := request.GetInt("precision", defaultPrecision)
+		return mcp.NewToolResultText(formatResult(result, precision)), nil
 	})
 	log.Println("四則演算ツールが追加されました")
 
-	log.Println("標準入出力でサーバーを起動します...")
+	// evaluate・科学計算ツールの登録
+	registerScientificTools(s)
+	log.Println("evaluate・科学計算ツールが追加されました")
+
 	// サーバーの起動
-	if err := server.ServeStdio(s); err != nil {
+	cfg := transportConfig{
+		transport: *transport,
+		addr:      *addr,
+		basePath:  *basePath,
+		authToken: *authToken,
+	}
+	if err := serve(s, cfg); err != nil {
 		log.Printf("サーバーエラー: %v\n", err)
 		fmt.Printf("サーバーエラー: %v\n", err)
 	}
-	log.Println("サーバーが起動しました")
+	log.Println("サーバーが終了しました")
 }
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultPrecision はprecision引数が未指定の場合に使用する小数点以下の桁数です。
+const defaultPrecision = 2
+
+// formatResult はvalueをprecisionで指定した桁数の小数として整形します。
+func formatResult(value float64, precision int) string {
+	return fmt.Sprintf("%.*f", precision, value)
+}
+
+// registerScientificTools はevaluateツールと各種科学計算ツールをサーバーに登録します。
+func registerScientificTools(s *server.MCPServer) {
+	s.AddTool(
+		mcp.NewTool("evaluate",
+			mcp.WithDescription("任意の中置記法の数式を評価します（例: sin(pi/4) + sqrt(2)^2）"),
+			mcp.WithString("expression",
+				mcp.Required(),
+				mcp.Description("評価する数式"),
+			),
+			mcp.WithNumber("precision",
+				mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）"),
+			),
+		),
+		handleEvaluate,
+	)
+
+	s.AddTool(
+		mcp.NewTool("pow",
+			mcp.WithDescription("xのy乗を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("底")),
+			mcp.WithNumber("y", mcp.Required(), mcp.Description("指数")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) { return math.Pow(args[0], args[1]), nil }, "x", "y"),
+	)
+
+	s.AddTool(
+		mcp.NewTool("sqrt",
+			mcp.WithDescription("xの平方根を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("対象の数値")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) { return sqrtFn(args[0]) }, "x"),
+	)
+
+	s.AddTool(
+		mcp.NewTool("log",
+			mcp.WithDescription("xの自然対数を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("対象の数値")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) { return logFn(args[0]) }, "x"),
+	)
+
+	s.AddTool(
+		mcp.NewTool("sin",
+			mcp.WithDescription("xの正弦（ラジアン）を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("対象の角度（ラジアン）")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) { return math.Sin(args[0]), nil }, "x"),
+	)
+
+	s.AddTool(
+		mcp.NewTool("cos",
+			mcp.WithDescription("xの余弦（ラジアン）を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("対象の角度（ラジアン）")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) { return math.Cos(args[0]), nil }, "x"),
+	)
+
+	s.AddTool(
+		mcp.NewTool("tan",
+			mcp.WithDescription("xの正接（ラジアン）を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("対象の角度（ラジアン）")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) { return math.Tan(args[0]), nil }, "x"),
+	)
+
+	s.AddTool(
+		mcp.NewTool("mod",
+			mcp.WithDescription("xをyで割った剰余を計算します"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("被除数")),
+			mcp.WithNumber("y", mcp.Required(), mcp.Description("除数")),
+			mcp.WithNumber("precision", mcp.Description("結果の小数点以下の桁数（未指定の場合は2桁）")),
+		),
+		newUnaryOpTool(func(args []float64) (float64, error) {
+			if args[1] == 0 {
+				return 0, fmt.Errorf("0による剰余演算はできません")
+			}
+			return math.Mod(args[0], args[1]), nil
+		}, "x", "y"),
+	)
+}
+
+// handleEvaluate はevaluateツールの実装です。
+func handleEvaluate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := request.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	precision := request.GetInt("precision", defaultPrecision)
+
+	result, err := evaluateExpression(expression)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatResult(result, precision)), nil
+}
+
+// newUnaryOpTool はargNamesで指定した引数を取得し、fnで計算してprecision桁で整形するツールハンドラーを返します。
+// 名前に反して1引数・2引数のいずれの演算にも対応します。
+func newUnaryOpTool(fn func(args []float64) (float64, error), argNames ...string) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := make([]float64, len(argNames))
+		for i, name := range argNames {
+			v, err := request.RequireFloat(name)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			args[i] = v
+		}
+		precision := request.GetInt("precision", defaultPrecision)
+
+		result, err := fn(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText(formatResult(result, precision)), nil
+	}
+}
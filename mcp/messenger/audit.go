@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// auditRecord はSlackへの書き込み系ツール呼び出し1回分の監査ログです。
+// メッセージ本文はそのまま記録せず、ハッシュのみを残します。
+type auditRecord struct {
+	Timestamp   time.Time `json:"timestamp"`
+	Tool        string    `json:"tool"`
+	Caller      string    `json:"caller,omitempty"`
+	Channel     string    `json:"channel"`
+	MessageHash string    `json:"message_hash"`
+	ResultTS    string    `json:"result_ts,omitempty"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// auditSink はauditRecordの出力先です。
+type auditSink interface {
+	write(record auditRecord) error
+}
+
+// stderrAuditSink は標準エラー出力に1行JSONとして書き込みます。
+type stderrAuditSink struct{}
+
+func (stderrAuditSink) write(record auditRecord) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stderr, string(body))
+	return err
+}
+
+// fileAuditSink はファイルに1行JSONとして追記します。
+type fileAuditSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFileAuditSink(path string) *fileAuditSink {
+	return &fileAuditSink{path: path}
+}
+
+func (s *fileAuditSink) write(record auditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(body, '\n'))
+	return err
+}
+
+// webhookAuditSink はWebhook URLへJSONをPOSTします。
+type webhookAuditSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+func newWebhookAuditSink(url string) *webhookAuditSink {
+	return &webhookAuditSink{url: url, httpClient: http.DefaultClient}
+}
+
+func (s *webhookAuditSink) write(record auditRecord) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.httpClient.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("監査ログWebhookがステータス%dを返しました: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+// newAuditSinkFromEnv は環境変数から監査ログの出力先を構築します。
+// SLACK_AUDIT_WEBHOOK_URLが設定されていればWebhook、SLACK_AUDIT_LOG_PATHが設定されていればファイル、
+// どちらも未設定の場合は標準エラー出力を使用します。
+func newAuditSinkFromEnv() auditSink {
+	if url := os.Getenv("SLACK_AUDIT_WEBHOOK_URL"); url != "" {
+		return newWebhookAuditSink(url)
+	}
+	if path := os.Getenv("SLACK_AUDIT_LOG_PATH"); path != "" {
+		return newFileAuditSink(path)
+	}
+	return stderrAuditSink{}
+}
+
+// hashMessage はメッセージ本文を監査ログに残す際、内容を秘匿しつつ重複検出できるようSHA-256ハッシュに変換します。
+func hashMessage(message string) string {
+	sum := sha256.Sum256([]byte(message))
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	auditSinkOnce sync.Once
+	auditSinkInst auditSink
+)
+
+// getAuditSink はプロセス全体で共有する監査ログの出力先を返します。
+func getAuditSink() auditSink {
+	auditSinkOnce.Do(func() {
+		auditSinkInst = newAuditSinkFromEnv()
+	})
+	return auditSinkInst
+}
+
+// auditAndRateLimit はSlackへの書き込み系ツール呼び出しをレート制限で保護し、結果を監査ログに記録した上でsendを実行します。
+// レート制限に達した場合はsendを呼び出さずエラーを返します。
+func auditAndRateLimit(ctx context.Context, tool, channel, message string, send func() (string, error)) (string, error) {
+	record := auditRecord{
+		Timestamp:   time.Now(),
+		Tool:        tool,
+		Caller:      callerIDFromContext(ctx),
+		Channel:     channel,
+		MessageHash: hashMessage(message),
+	}
+
+	if err := getRateLimiter().allow(channel); err != nil {
+		record.Error = err.Error()
+		_ = getAuditSink().write(record)
+		return "", err
+	}
+
+	result, err := send()
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.ResultTS = result
+	}
+	_ = getAuditSink().write(record)
+	return result, err
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHashMessage(t *testing.T) {
+	h1 := hashMessage("hello")
+	h2 := hashMessage("hello")
+	h3 := hashMessage("world")
+
+	if h1 != h2 {
+		t.Errorf("hashMessage should be deterministic for the same input: %q != %q", h1, h2)
+	}
+	if h1 == h3 {
+		t.Error("hashMessage should return different hashes for different input")
+	}
+	if h1 == "hello" {
+		t.Error("hashMessage must not leak the raw message")
+	}
+	if len(h1) != 64 {
+		t.Errorf("hashMessage should return a hex-encoded SHA-256 digest (64 chars), got %d chars", len(h1))
+	}
+}
+
+func TestNewAuditSinkFromEnv(t *testing.T) {
+	t.Run("defaults to stderr", func(t *testing.T) {
+		t.Setenv("SLACK_AUDIT_WEBHOOK_URL", "")
+		t.Setenv("SLACK_AUDIT_LOG_PATH", "")
+
+		if _, ok := newAuditSinkFromEnv().(stderrAuditSink); !ok {
+			t.Errorf("expected stderrAuditSink, got %T", newAuditSinkFromEnv())
+		}
+	})
+
+	t.Run("log path selects the file sink", func(t *testing.T) {
+		t.Setenv("SLACK_AUDIT_WEBHOOK_URL", "")
+		t.Setenv("SLACK_AUDIT_LOG_PATH", filepath.Join(t.TempDir(), "audit.log"))
+
+		if _, ok := newAuditSinkFromEnv().(*fileAuditSink); !ok {
+			t.Errorf("expected *fileAuditSink, got %T", newAuditSinkFromEnv())
+		}
+	})
+
+	t.Run("webhook URL takes precedence over log path", func(t *testing.T) {
+		t.Setenv("SLACK_AUDIT_WEBHOOK_URL", "https://example.com/hook")
+		t.Setenv("SLACK_AUDIT_LOG_PATH", filepath.Join(t.TempDir(), "audit.log"))
+
+		if _, ok := newAuditSinkFromEnv().(*webhookAuditSink); !ok {
+			t.Errorf("expected *webhookAuditSink, got %T", newAuditSinkFromEnv())
+		}
+	})
+}
+
+func TestFileAuditSinkWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink := newFileAuditSink(path)
+
+	record := auditRecord{Tool: "send_slack", Channel: "general", MessageHash: hashMessage("hi")}
+	if err := sink.write(record); err != nil {
+		t.Fatalf("write returned error: %v", err)
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+	if len(body) == 0 {
+		t.Fatal("expected the audit log file to contain the written record")
+	}
+}
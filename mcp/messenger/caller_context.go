@@ -0,0 +1,17 @@
+package main
+
+import "context"
+
+// callerIDContextKey はcontext.Valueに呼び出し元IDを格納する際のキー型です。
+type callerIDContextKey struct{}
+
+// contextWithCallerID はidを紐付けた新しいcontextを返します。
+func contextWithCallerID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, callerIDContextKey{}, id)
+}
+
+// callerIDFromContext はctxに紐付けられた呼び出し元IDを取り出します。未設定の場合は空文字列を返します。
+func callerIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(callerIDContextKey{}).(string)
+	return id
+}
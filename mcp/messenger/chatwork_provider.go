@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// chatworkProvider はChatworkへメッセージを送信するProvider実装です。
+type chatworkProvider struct {
+	token      string
+	httpClient *http.Client
+}
+
+// newChatworkProvider はAPIトークンを使ってchatworkProviderを作成します。
+func newChatworkProvider(token string) *chatworkProvider {
+	return &chatworkProvider{token: token, httpClient: http.DefaultClient}
+}
+
+func (p *chatworkProvider) Name() string {
+	return "chatwork"
+}
+
+// Send はChatworkのルームIDで指定したルームにメッセージを送信します。
+func (p *chatworkProvider) Send(ctx context.Context, channel, message string, opts SendOptions) (string, error) {
+	endpoint := fmt.Sprintf("https://api.chatwork.com/v2/rooms/%s/messages", channel)
+	form := url.Values{"body": {message}}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-ChatWorkToken", p.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Chatwork APIがステータス%dを返しました: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		MessageID int64 `json:"message_id"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(result.MessageID, 10), nil
+}
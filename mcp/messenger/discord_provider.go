@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// discordProvider はDiscordへメッセージを送信するProvider実装です。
+type discordProvider struct {
+	botToken   string
+	httpClient *http.Client
+}
+
+// newDiscordProvider はBotトークンを使ってdiscordProviderを作成します。
+func newDiscordProvider(botToken string) *discordProvider {
+	return &discordProvider{botToken: botToken, httpClient: http.DefaultClient}
+}
+
+func (p *discordProvider) Name() string {
+	return "discord"
+}
+
+// Send はDiscord APIを使ってchannel（チャンネルID）にメッセージを送信します。
+// opts.ThreadIDが指定された場合はmessage_referenceとして返信元メッセージに紐付けます。
+func (p *discordProvider) Send(ctx context.Context, channel, message string, opts SendOptions) (string, error) {
+	endpoint := fmt.Sprintf("https://discord.com/api/v10/channels/%s/messages", channel)
+	payload := map[string]any{
+		"content": message,
+	}
+	if opts.ThreadID != "" {
+		payload["message_reference"] = map[string]string{"message_id": opts.ThreadID}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bot "+p.botToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Discord APIがステータス%dを返しました: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
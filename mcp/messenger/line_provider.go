@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// lineProvider はLINEへメッセージを送信するProvider実装です。
+type lineProvider struct {
+	channelAccessToken string
+	httpClient         *http.Client
+}
+
+// newLineProvider はチャネルアクセストークンを使ってlineProviderを作成します。
+func newLineProvider(channelAccessToken string) *lineProvider {
+	return &lineProvider{channelAccessToken: channelAccessToken, httpClient: http.DefaultClient}
+}
+
+func (p *lineProvider) Name() string {
+	return "line"
+}
+
+// Send はLINEのプッシュメッセージAPIを使ってユーザーまたはグループにメッセージを送信します。
+// LINEのプッシュメッセージAPIはメッセージIDを返さないため、送信に成功した場合は空文字列を返します。
+func (p *lineProvider) Send(ctx context.Context, channel, message string, opts SendOptions) (string, error) {
+	payload := map[string]any{
+		"to": channel,
+		"messages": []map[string]string{
+			{"type": "text", "text": message},
+		},
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.line.me/v2/bot/message/push", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.channelAccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("LINE APIがステータス%dを返しました: %s", resp.StatusCode, string(errBody))
+	}
+	return "", nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func main() {
+	// トランスポート設定の読み込み（未指定時は環境変数、さらに未設定ならデフォルト値）
+	transport := flag.String("transport", envOrDefault("MCP_TRANSPORT", "stdio"), "利用するトランスポート（stdio, sse, streamable-http）")
+	addr := flag.String("addr", envOrDefault("MCP_ADDR", ":8080"), "SSE/streamable-httpサーバーのバインドアドレス")
+	basePath := flag.String("base-path", envOrDefault("MCP_BASE_PATH", ""), "SSE/streamable-httpエンドポイントのパスプレフィックス")
+	authToken := flag.String("auth-token", envOrDefault("MCP_AUTH_TOKEN", ""), "SSE/streamable-httpで要求するBearerトークン（空の場合は認証なし）")
+	flag.Parse()
+
+	log.Println("messenger MCPサーバーを起動します...")
+
+	// MCPサーバーインスタンスの作成
+	s := server.NewMCPServer(
+		"messenger",
+		"1.0.0",
+		server.WithResourceCapabilities(true, true),
+		server.WithLogging(),
+	)
+	log.Println("messenger MCPサーバーが作成されました")
+
+	// 環境変数で認証情報が設定されているプロバイダーのみを有効化する
+	var providers []Provider
+	if token := os.Getenv("SLACK_API_TOKEN"); token != "" {
+		providers = append(providers, newSlackProvider(token))
+	}
+	if token := os.Getenv("CHATWORK_API_TOKEN"); token != "" {
+		providers = append(providers, newChatworkProvider(token))
+	}
+	if token := os.Getenv("LINE_CHANNEL_ACCESS_TOKEN"); token != "" {
+		providers = append(providers, newLineProvider(token))
+	}
+	if token := os.Getenv("TELEGRAM_BOT_TOKEN"); token != "" {
+		providers = append(providers, newTelegramProvider(token))
+	}
+	if token := os.Getenv("DISCORD_BOT_TOKEN"); token != "" {
+		providers = append(providers, newDiscordProvider(token))
+	}
+	if len(providers) == 0 {
+		log.Println("警告: 認証情報が設定されたプロバイダーがありません（SLACK_API_TOKEN等を設定してください）")
+	}
+
+	// 有効なプロバイダーごとにsend_<provider>ツールを登録する
+	for _, p := range providers {
+		registerSendTool(s, p)
+	}
+
+	// Slack専用ツールはSlackが有効な場合のみ登録する
+	if os.Getenv("SLACK_API_TOKEN") != "" {
+		s.AddTool(newReadMessagesTool(), handleReadMessages)
+		s.AddTool(newSendRichMessageTool(), handleSendRichMessage)
+		s.AddTool(newReplyInThreadTool(), handleReplyInThread)
+		s.AddTool(newUploadFileTool(), handleUploadFile)
+		registerSlackResources(s)
+		log.Println("Slack専用ツールとリソースが追加されました")
+	}
+
+	// サーバーの起動
+	cfg := transportConfig{
+		transport: *transport,
+		addr:      *addr,
+		basePath:  *basePath,
+		authToken: *authToken,
+	}
+	if err := serve(s, cfg); err != nil {
+		log.Fatalf("サーバーエラー: %v", err)
+	}
+	log.Println("サーバーが終了しました")
+}
+
+// registerSendTool はproviderに対応するsend_<provider名>ツールをサーバーに登録します。
+func registerSendTool(s *server.MCPServer, p Provider) {
+	toolName := "send_" + p.Name()
+	tool := mcp.NewTool(toolName,
+		mcp.WithDescription(p.Name()+"にメッセージを送信する"),
+		mcp.WithString("channel",
+			mcp.Required(),
+			mcp.Description("送信先のチャンネル／ルーム／ユーザーを識別するID"),
+		),
+		mcp.WithString("message",
+			mcp.Required(),
+			mcp.Description("送信するメッセージテキスト"),
+		),
+		mcp.WithString("thread_id",
+			mcp.Description("返信先スレッドのID（対応していないプロバイダーでは無視される）"),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channel, err := request.RequireString("channel")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		message, err := request.RequireString("message")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts := SendOptions{ThreadID: request.GetString("thread_id", "")}
+
+		id, err := p.Send(ctx, channel, message, opts)
+		if err != nil {
+			return mcp.NewToolResultError(p.Name() + "へのメッセージ送信に失敗しました: " + err.Error()), nil
+		}
+		return mcp.NewToolResultText(p.Name() + "にメッセージを送信しました。ID: " + id), nil
+	})
+	log.Printf("%sツールが追加されました\n", toolName)
+}
@@ -0,0 +1,18 @@
+package main
+
+import "context"
+
+// SendOptions はメッセージ送信時に指定できる追加オプションです。
+// プロバイダーによっては一部のフィールドを無視します。
+type SendOptions struct {
+	ThreadID string // 返信先スレッドのID
+}
+
+// Provider は特定のチャットサービスへメッセージを送信するためのインターフェースです。
+// 新しいチャットサービスへの対応はこのインターフェースの実装を追加するだけで済みます。
+type Provider interface {
+	// Name はプロバイダー名を返します（例："slack"）。
+	Name() string
+	// Send はchannelへmessageを送信し、送信結果を識別するIDを返します。
+	Send(ctx context.Context, channel, message string, opts SendOptions) (id string, err error)
+}
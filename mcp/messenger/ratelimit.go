@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter はSlackへの書き込みをグローバルおよびチャンネル単位で制限します。
+type rateLimiter struct {
+	global *rate.Limiter
+
+	mu           sync.Mutex
+	channelRPS   float64
+	channelBurst int
+	channels     map[string]*rate.Limiter
+}
+
+// newRateLimiter はglobalRPS/globalBurstでグローバルな上限を、channelRPS/channelBurstで
+// チャンネルごとの上限を設定したrateLimiterを作成します。チャンネルごとのリミッターは初回アクセス時に遅延生成されます。
+func newRateLimiter(globalRPS float64, globalBurst int, channelRPS float64, channelBurst int) *rateLimiter {
+	return &rateLimiter{
+		global:       rate.NewLimiter(rate.Limit(globalRPS), globalBurst),
+		channelRPS:   channelRPS,
+		channelBurst: channelBurst,
+		channels:     make(map[string]*rate.Limiter),
+	}
+}
+
+// newRateLimiterFromEnv は環境変数からレート制限の設定を読み込みます。
+// SLACK_RATE_LIMIT_GLOBAL_RPS/SLACK_RATE_LIMIT_GLOBAL_BURST/SLACK_RATE_LIMIT_CHANNEL_RPS/SLACK_RATE_LIMIT_CHANNEL_BURSTが対象です。
+func newRateLimiterFromEnv() *rateLimiter {
+	return newRateLimiter(
+		envOrDefaultFloat("SLACK_RATE_LIMIT_GLOBAL_RPS", 5),
+		envOrDefaultInt("SLACK_RATE_LIMIT_GLOBAL_BURST", 10),
+		envOrDefaultFloat("SLACK_RATE_LIMIT_CHANNEL_RPS", 1),
+		envOrDefaultInt("SLACK_RATE_LIMIT_CHANNEL_BURST", 3),
+	)
+}
+
+// allow はchannelへの書き込みを1件消費できるか判定します。グローバルまたはチャンネル単位の上限を
+// 超えている場合はエラーを返します。
+func (l *rateLimiter) allow(channel string) error {
+	if !l.global.Allow() {
+		return fmt.Errorf("レート制限を超えました（全体）: しばらく待ってから再度お試しください")
+	}
+	if !l.channelLimiter(channel).Allow() {
+		return fmt.Errorf("レート制限を超えました（チャンネル: %s）: しばらく待ってから再度お試しください", channel)
+	}
+	return nil
+}
+
+// channelLimiter はchannel用のリミッターを返します。存在しない場合は生成して登録します。
+func (l *rateLimiter) channelLimiter(channel string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.channels[channel]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(l.channelRPS), l.channelBurst)
+		l.channels[channel] = limiter
+	}
+	return limiter
+}
+
+var (
+	rateLimiterOnce sync.Once
+	rateLimiterInst *rateLimiter
+)
+
+// getRateLimiter はプロセス全体で共有するレートリミッターを返します。
+func getRateLimiter() *rateLimiter {
+	rateLimiterOnce.Do(func() {
+		rateLimiterInst = newRateLimiterFromEnv()
+	})
+	return rateLimiterInst
+}
+
+// envOrDefaultFloat はキーに対応する環境変数をfloat64として読み込みます。未設定または変換失敗時はdefaultValueを返します。
+func envOrDefaultFloat(key string, defaultValue float64) float64 {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return defaultValue
+	}
+	return f
+}
+
+// envOrDefaultInt はキーに対応する環境変数をintとして読み込みます。未設定または変換失敗時はdefaultValueを返します。
+func envOrDefaultInt(key string, defaultValue int) int {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return defaultValue
+	}
+	return i
+}
@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestRateLimiterAllowGlobalExhaustion(t *testing.T) {
+	l := newRateLimiter(0, 2, 0, 100)
+
+	if err := l.allow("general"); err != nil {
+		t.Fatalf("1st call: unexpected error: %v", err)
+	}
+	if err := l.allow("random"); err != nil {
+		t.Fatalf("2nd call: unexpected error: %v", err)
+	}
+	if err := l.allow("general"); err == nil {
+		t.Fatal("3rd call: expected the global rate limit to be exceeded, got nil")
+	}
+}
+
+func TestRateLimiterAllowChannelExhaustion(t *testing.T) {
+	l := newRateLimiter(0, 100, 0, 1)
+
+	if err := l.allow("general"); err != nil {
+		t.Fatalf("1st call to #general: unexpected error: %v", err)
+	}
+	if err := l.allow("general"); err == nil {
+		t.Fatal("2nd call to #general: expected the channel rate limit to be exceeded, got nil")
+	}
+	// A different channel has its own bucket and must be unaffected by #general's limit.
+	if err := l.allow("random"); err != nil {
+		t.Fatalf("1st call to #random: unexpected error: %v", err)
+	}
+}
+
+func TestRateLimiterChannelLimiterReused(t *testing.T) {
+	l := newRateLimiter(0, 100, 0, 1)
+
+	a := l.channelLimiter("general")
+	b := l.channelLimiter("general")
+	if a != b {
+		t.Fatal("channelLimiter should return the same *rate.Limiter for the same channel across calls")
+	}
+}
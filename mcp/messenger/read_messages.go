@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/slack-go/slack"
+)
+
+// historyMessage はread_messagesツールが返すJSONの1メッセージ分の構造です。
+type historyMessage struct {
+	User      string `json:"user"`
+	Text      string `json:"text"`
+	Timestamp string `json:"ts"`
+	ThreadTS  string `json:"thread_ts,omitempty"`
+}
+
+// newReadMessagesTool はread_messagesツールのインターフェース定義を返します。
+func newReadMessagesTool() mcp.Tool {
+	return mcp.NewTool("read_messages",
+		mcp.WithDescription("Slackチャンネルまたはスレッドのメッセージ履歴を取得する"),
+		mcp.WithString("channel",
+			mcp.Required(),
+			mcp.Description("履歴を取得するSlackチャンネル（例：#general）またはチャンネルID"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("取得するメッセージの最大件数（未指定の場合はSlack APIのデフォルト値）"),
+		),
+		mcp.WithString("oldest",
+			mcp.Description("この時刻（Slackタイムスタンプ）以降のメッセージのみを取得する"),
+		),
+		mcp.WithString("latest",
+			mcp.Description("この時刻（Slackタイムスタンプ）以前のメッセージのみを取得する"),
+		),
+		mcp.WithString("thread_ts",
+			mcp.Description("指定した場合、このスレッドの返信一覧を取得する（チャンネル全体の履歴ではなく）"),
+		),
+	)
+}
+
+// handleReadMessages はread_messagesツールの実装です。
+func handleReadMessages(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Slack APIトークンの取得
+	token := os.Getenv("SLACK_API_TOKEN")
+	if token == "" {
+		return mcp.NewToolResultError("SLACK_API_TOKEN環境変数が設定されていません"), nil
+	}
+
+	// パラメータの取得
+	channel, err := request.RequireString("channel")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if channel == "" {
+		return mcp.NewToolResultError("チャンネルを指定してください"), nil
+	}
+	limit := request.GetInt("limit", 0)
+	oldest := request.GetString("oldest", "")
+	latest := request.GetString("latest", "")
+	threadTS := request.GetString("thread_ts", "")
+
+	// Slackクライアントの作成
+	api := slack.New(token)
+
+	var messages []slack.Message
+	if threadTS != "" {
+		// スレッドの返信一覧を取得
+		messages, _, _, err = api.GetConversationRepliesContext(ctx, &slack.GetConversationRepliesParameters{
+			ChannelID: channel,
+			Timestamp: threadTS,
+			Limit:     limit,
+			Oldest:    oldest,
+			Latest:    latest,
+		})
+		if err != nil {
+			return mcp.NewToolResultError("スレッドの取得に失敗しました: " + err.Error()), nil
+		}
+	} else {
+		// チャンネルの履歴を取得
+		resp, histErr := api.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+			ChannelID: channel,
+			Limit:     limit,
+			Oldest:    oldest,
+			Latest:    latest,
+		})
+		if histErr != nil {
+			return mcp.NewToolResultError("メッセージ履歴の取得に失敗しました: " + histErr.Error()), nil
+		}
+		messages = resp.Messages
+	}
+
+	// 結果をJSONに整形
+	result := make([]historyMessage, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, historyMessage{
+			User:      m.User,
+			Text:      m.Text,
+			Timestamp: m.Timestamp,
+			ThreadTS:  m.ThreadTimestamp,
+		})
+	}
+	body, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError("結果のJSON変換に失敗しました: " + err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(string(body)), nil
+}
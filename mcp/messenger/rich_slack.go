@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/slack-go/slack"
+)
+
+// newSendRichMessageTool はsend_rich_messageツールのインターフェース定義を返します。
+func newSendRichMessageTool() mcp.Tool {
+	return mcp.NewTool("send_rich_message",
+		mcp.WithDescription("Block KitのブロックやAttachmentを使ってSlackにリッチなメッセージを送信する"),
+		mcp.WithString("channel",
+			mcp.Required(),
+			mcp.Description("メッセージを送信するSlackチャンネル（例：#general）またはユーザーID"),
+		),
+		mcp.WithString("text",
+			mcp.Description("通知やブロック非対応クライアント向けのフォールバックテキスト"),
+		),
+		mcp.WithString("blocks",
+			mcp.Description("Block Kitのブロックを表すJSON配列文字列"),
+		),
+		mcp.WithString("attachments",
+			mcp.Description("Attachmentを表すJSON配列文字列"),
+		),
+		mcp.WithString("thread_ts",
+			mcp.Description("指定した場合、このタイムスタンプのスレッドに返信する"),
+		),
+		mcp.WithBoolean("reply_broadcast",
+			mcp.Description("スレッド返信をチャンネルにも表示するかどうか（thread_ts指定時のみ有効）"),
+		),
+	)
+}
+
+// handleSendRichMessage はsend_rich_messageツールの実装です。
+func handleSendRichMessage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	token := os.Getenv("SLACK_API_TOKEN")
+	if token == "" {
+		return mcp.NewToolResultError("SLACK_API_TOKEN環境変数が設定されていません"), nil
+	}
+
+	channel, err := request.RequireString("channel")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	text := request.GetString("text", "")
+	blocksJSON := request.GetString("blocks", "")
+	attachmentsJSON := request.GetString("attachments", "")
+	threadTS := request.GetString("thread_ts", "")
+	replyBroadcast := request.GetBool("reply_broadcast", false)
+
+	msgOpts := []slack.MsgOption{slack.MsgOptionText(text, false)}
+
+	if blocksJSON != "" {
+		var blocks slack.Blocks
+		if err := json.Unmarshal([]byte(blocksJSON), &blocks); err != nil {
+			return mcp.NewToolResultError("blocksのJSONが不正です: " + err.Error()), nil
+		}
+		msgOpts = append(msgOpts, slack.MsgOptionBlocks(blocks.BlockSet...))
+	}
+
+	if attachmentsJSON != "" {
+		var attachments []slack.Attachment
+		if err := json.Unmarshal([]byte(attachmentsJSON), &attachments); err != nil {
+			return mcp.NewToolResultError("attachmentsのJSONが不正です: " + err.Error()), nil
+		}
+		msgOpts = append(msgOpts, slack.MsgOptionAttachments(attachments...))
+	}
+
+	if threadTS != "" {
+		msgOpts = append(msgOpts, slack.MsgOptionTS(threadTS))
+		if replyBroadcast {
+			msgOpts = append(msgOpts, slack.MsgOptionBroadcast())
+		}
+	}
+
+	api := slack.New(token)
+	var channelID string
+	timestamp, err := auditAndRateLimit(ctx, "send_rich_message", channel, text, func() (string, error) {
+		id, ts, err := api.PostMessageContext(ctx, channel, msgOpts...)
+		channelID = id
+		return ts, err
+	})
+	if err != nil {
+		return mcp.NewToolResultError("メッセージ送信に失敗しました: " + err.Error()), nil
+	}
+
+	permalink, err := api.GetPermalinkContext(ctx, &slack.PermalinkParameters{Channel: channelID, Ts: timestamp})
+	if err != nil {
+		// パーマリンクの取得に失敗しても送信自体は成功しているため、タイムスタンプのみ返す
+		return mcp.NewToolResultText("メッセージを送信しました。チャンネル: " + channelID + ", タイムスタンプ: " + timestamp), nil
+	}
+
+	return mcp.NewToolResultText("メッセージを送信しました。パーマリンク: " + permalink), nil
+}
+
+// newReplyInThreadTool はreply_in_threadツールのインターフェース定義を返します。
+func newReplyInThreadTool() mcp.Tool {
+	return mcp.NewTool("reply_in_thread",
+		mcp.WithDescription("Slackのスレッドに返信する"),
+		mcp.WithString("channel",
+			mcp.Required(),
+			mcp.Description("スレッドが存在するSlackチャンネル"),
+		),
+		mcp.WithString("thread_ts",
+			mcp.Required(),
+			mcp.Description("返信先スレッドの親メッセージのタイムスタンプ"),
+		),
+		mcp.WithString("message",
+			mcp.Required(),
+			mcp.Description("返信するメッセージテキスト"),
+		),
+		mcp.WithBoolean("reply_broadcast",
+			mcp.Description("返信をチャンネルにも表示するかどうか"),
+		),
+	)
+}
+
+// handleReplyInThread はreply_in_threadツールの実装です。
+func handleReplyInThread(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	token := os.Getenv("SLACK_API_TOKEN")
+	if token == "" {
+		return mcp.NewToolResultError("SLACK_API_TOKEN環境変数が設定されていません"), nil
+	}
+
+	channel, err := request.RequireString("channel")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	threadTS, err := request.RequireString("thread_ts")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	message, err := request.RequireString("message")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	replyBroadcast := request.GetBool("reply_broadcast", false)
+
+	msgOpts := []slack.MsgOption{slack.MsgOptionText(message, false), slack.MsgOptionTS(threadTS)}
+	if replyBroadcast {
+		msgOpts = append(msgOpts, slack.MsgOptionBroadcast())
+	}
+
+	api := slack.New(token)
+	var channelID string
+	timestamp, err := auditAndRateLimit(ctx, "reply_in_thread", channel, message, func() (string, error) {
+		id, ts, err := api.PostMessageContext(ctx, channel, msgOpts...)
+		channelID = id
+		return ts, err
+	})
+	if err != nil {
+		return mcp.NewToolResultError("スレッドへの返信に失敗しました: " + err.Error()), nil
+	}
+
+	return mcp.NewToolResultText("スレッドに返信しました。チャンネル: " + channelID + ", タイムスタンプ: " + timestamp), nil
+}
+
+// newUploadFileTool はupload_fileツールのインターフェース定義を返します。
+func newUploadFileTool() mcp.Tool {
+	return mcp.NewTool("upload_file",
+		mcp.WithDescription("Slackチャンネルにファイルをアップロードする"),
+		mcp.WithString("channel",
+			mcp.Required(),
+			mcp.Description("アップロード先のSlackチャンネル"),
+		),
+		mcp.WithString("filename",
+			mcp.Required(),
+			mcp.Description("アップロードするファイル名"),
+		),
+		mcp.WithString("content",
+			mcp.Required(),
+			mcp.Description("ファイルの内容（base64がtrueの場合はBase64エンコードされた文字列）"),
+		),
+		mcp.WithBoolean("base64",
+			mcp.Description("contentがBase64エンコードされているかどうか"),
+		),
+		mcp.WithString("title",
+			mcp.Description("ファイルのタイトル"),
+		),
+		mcp.WithString("initial_comment",
+			mcp.Description("ファイルと一緒に投稿するコメント"),
+		),
+		mcp.WithString("thread_ts",
+			mcp.Description("指定した場合、このスレッドにファイルを投稿する"),
+		),
+	)
+}
+
+// handleUploadFile はupload_fileツールの実装です。
+func handleUploadFile(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	token := os.Getenv("SLACK_API_TOKEN")
+	if token == "" {
+		return mcp.NewToolResultError("SLACK_API_TOKEN環境変数が設定されていません"), nil
+	}
+
+	channel, err := request.RequireString("channel")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	filename, err := request.RequireString("filename")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	content, err := request.RequireString("content")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if request.GetBool("base64", false) {
+		decoded, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return mcp.NewToolResultError("contentのBase64デコードに失敗しました: " + err.Error()), nil
+		}
+		content = string(decoded)
+	}
+
+	api := slack.New(token)
+	var title string
+	id, err := auditAndRateLimit(ctx, "upload_file", channel, filename, func() (string, error) {
+		summary, err := api.UploadFileV2Context(ctx, slack.UploadFileV2Parameters{
+			Channel:         channel,
+			Filename:        filename,
+			Reader:          strings.NewReader(content),
+			FileSize:        len(content),
+			Title:           request.GetString("title", ""),
+			InitialComment:  request.GetString("initial_comment", ""),
+			ThreadTimestamp: request.GetString("thread_ts", ""),
+		})
+		if err != nil {
+			return "", err
+		}
+		title = summary.Title
+		return summary.ID, nil
+	})
+	if err != nil {
+		return mcp.NewToolResultError("ファイルのアップロードに失敗しました: " + err.Error()), nil
+	}
+
+	return mcp.NewToolResultText("ファイルをアップロードしました。ID: " + id + ", タイトル: " + title), nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+)
+
+// slackProvider はSlackへメッセージを送信するProvider実装です。
+type slackProvider struct {
+	api *slack.Client
+}
+
+// newSlackProvider はtokenを使ってslackProviderを作成します。
+func newSlackProvider(token string) *slackProvider {
+	return &slackProvider{api: slack.New(token)}
+}
+
+func (p *slackProvider) Name() string {
+	return "slack"
+}
+
+// Send はSlackチャンネルまたはユーザーにメッセージを送信します。監査ログの記録とレート制限はauditAndRateLimitが行います。
+func (p *slackProvider) Send(ctx context.Context, channel, message string, opts SendOptions) (string, error) {
+	return auditAndRateLimit(ctx, "send_slack", channel, message, func() (string, error) {
+		msgOpts := []slack.MsgOption{slack.MsgOptionText(message, false)}
+		if opts.ThreadID != "" {
+			msgOpts = append(msgOpts, slack.MsgOptionTS(opts.ThreadID))
+		}
+
+		_, timestamp, err := p.api.PostMessageContext(ctx, channel, msgOpts...)
+		if err != nil {
+			return "", err
+		}
+		return timestamp, nil
+	})
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/slack-go/slack"
+)
+
+// registerSlackResources はSlackチャンネル・ユーザー情報をリソースとして公開します。
+func registerSlackResources(s *server.MCPServer) {
+	s.AddResource(
+		mcp.NewResource("slack://channels", "Slackチャンネル一覧",
+			mcp.WithResourceDescription("ワークスペース内のチャンネル一覧"),
+			mcp.WithMIMEType("application/json"),
+		),
+		handleSlackChannelsResource,
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate("slack://channels/{id}/members", "Slackチャンネルのメンバー一覧",
+			mcp.WithTemplateDescription("指定したチャンネルに参加しているユーザーIDの一覧"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		handleSlackChannelMembersResource,
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate("slack://users/{id}", "Slackユーザー情報",
+			mcp.WithTemplateDescription("指定したユーザーIDのプロフィール情報"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		handleSlackUserResource,
+	)
+}
+
+// handleSlackChannelsResource はslack://channelsリソースの読み取りを処理します。
+func handleSlackChannelsResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	api := slack.New(os.Getenv("SLACK_API_TOKEN"))
+
+	channels, _, err := api.GetConversationsContext(ctx, &slack.GetConversationsParameters{
+		ExcludeArchived: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type channelSummary struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	summaries := make([]channelSummary, 0, len(channels))
+	for _, c := range channels {
+		summaries = append(summaries, channelSummary{ID: c.ID, Name: c.Name})
+	}
+
+	body, err := json.Marshal(summaries)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
+
+// handleSlackChannelMembersResource はslack://channels/{id}/membersリソースの読み取りを処理します。
+func handleSlackChannelMembersResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	channelID, _ := request.Params.Arguments["id"].(string)
+
+	api := slack.New(os.Getenv("SLACK_API_TOKEN"))
+	members, _, err := api.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{
+		ChannelID: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(members)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
+
+// handleSlackUserResource はslack://users/{id}リソースの読み取りを処理します。
+func handleSlackUserResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	userID, _ := request.Params.Arguments["id"].(string)
+
+	api := slack.New(os.Getenv("SLACK_API_TOKEN"))
+	user, err := api.GetUserInfoContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// telegramProvider はTelegramへメッセージを送信するProvider実装です。
+type telegramProvider struct {
+	botToken   string
+	httpClient *http.Client
+}
+
+// newTelegramProvider はBotトークンを使ってtelegramProviderを作成します。
+func newTelegramProvider(botToken string) *telegramProvider {
+	return &telegramProvider{botToken: botToken, httpClient: http.DefaultClient}
+}
+
+func (p *telegramProvider) Name() string {
+	return "telegram"
+}
+
+// Send はTelegram Bot APIのsendMessageを使ってチャットにメッセージを送信します。
+func (p *telegramProvider) Send(ctx context.Context, channel, message string, opts SendOptions) (string, error) {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", p.botToken)
+	payload := map[string]any{
+		"chat_id": channel,
+		"text":    message,
+	}
+	if opts.ThreadID != "" {
+		replyToMessageID, err := strconv.Atoi(opts.ThreadID)
+		if err != nil {
+			return "", fmt.Errorf("thread_idはTelegramのメッセージIDとして不正です: %s", opts.ThreadID)
+		}
+		payload["reply_to_message_id"] = replyToMessageID
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Telegram APIがステータス%dを返しました: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			MessageID int `json:"message_id"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(result.Result.MessageID), nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// callerIDHeader はリクエスト元を識別するために参照するHTTPヘッダー名です。
+// 監査ログにそのまま記録されるため、認証済みのゲートウェイ等で設定されることを想定しています。
+const callerIDHeader = "X-Caller-Id"
+
+// withCallerIDFromHeader はcallerIDHeaderの値をcontextに埋め込みます。監査ログから呼び出し元を追跡するために使用します。
+func withCallerIDFromHeader(ctx context.Context, r *http.Request) context.Context {
+	if id := r.Header.Get(callerIDHeader); id != "" {
+		return contextWithCallerID(ctx, id)
+	}
+	return ctx
+}
+
+// transportConfig はコマンドライン引数または環境変数から読み込むトランスポート設定です。
+type transportConfig struct {
+	transport string // stdio, sse, streamable-http
+	addr      string // SSE/HTTPサーバーのバインドアドレス
+	basePath  string // エンドポイントのパスプレフィックス
+	authToken string // HTTPトランスポートで要求するBearerトークン（空の場合は認証なし）
+}
+
+// envOrDefault は環境変数が設定されていればその値を、なければdefaultValueを返します。
+func envOrDefault(key, defaultValue string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+// requireBearerToken はtokenが空でない場合のみAuthorizationヘッダーを検証するミドルウェアを返します。
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "認証に失敗しました", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// serve はcfgで指定されたトランスポードでMCPサーバーを起動します。
+func serve(s *server.MCPServer, cfg transportConfig) error {
+	switch cfg.transport {
+	case "", "stdio":
+		log.Println("標準入出力でサーバーを起動します...")
+		return server.ServeStdio(s)
+
+	case "sse":
+		sseServer := server.NewSSEServer(s, server.WithBasePath(cfg.basePath), server.WithSSEContextFunc(withCallerIDFromHeader))
+		mux := http.NewServeMux()
+		mux.Handle(cfg.basePath+"/", requireBearerToken(cfg.authToken, sseServer))
+		httpServer := &http.Server{Addr: cfg.addr, Handler: mux}
+		log.Printf("SSEサーバーを %s (prefix=%q) で起動します...\n", cfg.addr, cfg.basePath)
+		return httpServer.ListenAndServe()
+
+	case "streamable-http":
+		endpointPath := cfg.basePath + "/mcp"
+		httpServer := server.NewStreamableHTTPServer(s, server.WithEndpointPath(endpointPath), server.WithHTTPContextFunc(withCallerIDFromHeader))
+		mux := http.NewServeMux()
+		mux.Handle(endpointPath, requireBearerToken(cfg.authToken, httpServer))
+		srv := &http.Server{Addr: cfg.addr, Handler: mux}
+		log.Printf("streamable-httpサーバーを %s%s で起動します...\n", cfg.addr, endpointPath)
+		return srv.ListenAndServe()
+
+	default:
+		return fmt.Errorf("不明なトランスポートです: %s（stdio, sse, streamable-httpのいずれかを指定してください）", cfg.transport)
+	}
+}